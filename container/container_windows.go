@@ -3,7 +3,6 @@
 package container
 
 import (
-	"fmt"
 	"os"
 	"path/filepath"
 
@@ -58,15 +57,8 @@ func (container *Container) TmpfsMounts() []Mount {
 func (container *Container) UpdateContainer(hostConfig *containertypes.HostConfig) error {
 	container.Lock()
 	defer container.Unlock()
-	resources := hostConfig.Resources
-	if resources.BlkioWeight != 0 || resources.CPUShares != 0 ||
-		resources.CPUPeriod != 0 || resources.CPUQuota != 0 ||
-		resources.CpusetCpus != "" || resources.CpusetMems != "" ||
-		resources.Memory != 0 || resources.MemorySwap != 0 ||
-		resources.MemoryReservation != 0 || resources.KernelMemory != 0 {
-		return fmt.Errorf("Resource updating isn't supported on Windows")
-	}
-	// update HostConfig of container
+	// Resource updates have already been rejected by the daemon in
+	// verifyPlatformContainerSettings, so only the restart policy is updated.
 	if hostConfig.RestartPolicy.Name != "" {
 		container.HostConfig.RestartPolicy = hostConfig.RestartPolicy
 	}
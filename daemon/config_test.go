@@ -275,4 +275,26 @@ func TestValidateConfiguration(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
+
+	c7 := &Config{
+		CommonConfig: CommonConfig{
+			DNSOptions: []string{"ndots:2", "timeout:60"},
+		},
+	}
+
+	err = validateConfiguration(c7)
+	if err != nil {
+		t.Fatalf("expected no error, got error %v", err)
+	}
+
+	c8 := &Config{
+		CommonConfig: CommonConfig{
+			DNSOptions: []string{"ndots:two"},
+		},
+	}
+
+	err = validateConfiguration(c8)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
 }
@@ -559,6 +559,11 @@ func verifyPlatformContainerSettings(daemon *Daemon, hostConfig *containertypes.
 	return warnings, nil
 }
 
+// addErrorAttributes adds platform specific details of err to the attributes
+// of the event logged for a failure. There are none on Unix.
+func addErrorAttributes(attributes map[string]string, err error) {
+}
+
 // verifyDaemonSettings performs validation of daemon config struct
 func verifyDaemonSettings(config *Config) error {
 	// Check for mutually incompatible config options
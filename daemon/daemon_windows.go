@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"syscall"
 
 	"github.com/Microsoft/hcsshim"
 	"github.com/Sirupsen/logrus"
@@ -97,9 +98,89 @@ func (daemon *Daemon) adaptContainerSettings(hostConfig *containertypes.HostConf
 // verifyPlatformContainerSettings performs platform-specific validation of the
 // hostconfig and config structures.
 func verifyPlatformContainerSettings(daemon *Daemon, hostConfig *containertypes.HostConfig, config *containertypes.Config, update bool) ([]string, error) {
+	// Settings which are rejected only when the container is created, as
+	// config is nil on start and update. Earlier versions silently ignored
+	// them, so existing containers which have them set must still start.
+	if config != nil {
+		if len(hostConfig.Devices) > 0 {
+			return nil, fmt.Errorf("Windows does not support adding host devices to a container (--device)")
+		}
+		if len(hostConfig.SecurityOpt) > 0 {
+			return nil, fmt.Errorf("Windows does not support security options such as seccomp or no-new-privileges (--security-opt)")
+		}
+		if len(hostConfig.CapAdd) > 0 || len(hostConfig.CapDrop) > 0 {
+			return nil, fmt.Errorf("Windows does not support Linux capabilities (--cap-add, --cap-drop)")
+		}
+	}
+
+	// The resources of a Windows container are fixed when the compute system
+	// is created, so only the restart policy can be updated.
+	if update {
+		if flags := updatedResourceFlags(hostConfig.Resources); len(flags) > 0 {
+			return nil, fmt.Errorf("Windows does not support updating the resources of a container (%s)", strings.Join(flags, ", "))
+		}
+	}
 	return nil, nil
 }
 
+// updatedResourceFlags returns the docker update flags corresponding to the
+// resources which are set in an update request.
+func updatedResourceFlags(resources containertypes.Resources) []string {
+	var flags []string
+	if resources.BlkioWeight != 0 {
+		flags = append(flags, "--blkio-weight")
+	}
+	if resources.CPUShares != 0 {
+		flags = append(flags, "--cpu-shares")
+	}
+	if resources.CPUPeriod != 0 {
+		flags = append(flags, "--cpu-period")
+	}
+	if resources.CPUQuota != 0 {
+		flags = append(flags, "--cpu-quota")
+	}
+	if resources.CpusetCpus != "" {
+		flags = append(flags, "--cpuset-cpus")
+	}
+	if resources.CpusetMems != "" {
+		flags = append(flags, "--cpuset-mems")
+	}
+	if resources.Memory != 0 {
+		flags = append(flags, "--memory")
+	}
+	if resources.MemoryReservation != 0 {
+		flags = append(flags, "--memory-reservation")
+	}
+	if resources.MemorySwap != 0 {
+		flags = append(flags, "--memory-swap")
+	}
+	if resources.KernelMemory != 0 {
+		flags = append(flags, "--kernel-memory")
+	}
+	return flags
+}
+
+// addErrorAttributes adds the HRESULT of a failed HCS call to the attributes
+// of the event logged for the failure, so that failures can be filtered on it.
+func addErrorAttributes(attributes map[string]string, err error) {
+	if herr, ok := err.(*hcsshim.HcsError); ok {
+		if errno, ok := herr.Err.(syscall.Errno); ok {
+			attributes["hresult"] = fmt.Sprintf("0x%08x", hresultFromErrno(errno))
+		}
+	}
+}
+
+// hresultFromErrno returns the HRESULT for an error code returned by HCS.
+// hcsshim converts Win32 facility HRESULTs back to plain Win32 error codes,
+// so these are converted again as HRESULT_FROM_WIN32 does.
+func hresultFromErrno(errno syscall.Errno) uint32 {
+	code := uint32(errno)
+	if code == 0 || code >= 0x10000 {
+		return code
+	}
+	return code | 0x80070000
+}
+
 // verifyDaemonSettings performs validation of daemon config struct
 func verifyDaemonSettings(config *Config) error {
 	return nil
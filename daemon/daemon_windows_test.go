@@ -0,0 +1,72 @@
+package daemon
+
+import (
+	"errors"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/Microsoft/hcsshim"
+	containertypes "github.com/docker/engine-api/types/container"
+)
+
+func TestVerifyPlatformContainerSettingsUpdate(t *testing.T) {
+	swappiness := int64(-1)
+	hostConfig := &containertypes.HostConfig{
+		Resources: containertypes.Resources{
+			Devices:          []containertypes.DeviceMapping{},
+			MemorySwappiness: &swappiness,
+		},
+		RestartPolicy: containertypes.RestartPolicy{Name: "always"},
+	}
+	if _, err := verifyPlatformContainerSettings(nil, hostConfig, nil, true); err != nil {
+		t.Fatalf("expected no error updating the restart policy, got %v", err)
+	}
+
+	hostConfig.Resources.Memory = 1024 * 1024 * 1024
+	hostConfig.Resources.CPUShares = 512
+	_, err := verifyPlatformContainerSettings(nil, hostConfig, nil, true)
+	if err == nil {
+		t.Fatal("expected an error updating resources")
+	}
+	if !strings.Contains(err.Error(), "--cpu-shares, --memory") {
+		t.Fatalf("expected the error to name the flags, got %v", err)
+	}
+}
+
+func TestVerifyPlatformContainerSettingsStart(t *testing.T) {
+	hostConfig := &containertypes.HostConfig{
+		SecurityOpt: []string{"no-new-privileges"},
+		Resources: containertypes.Resources{
+			Devices: []containertypes.DeviceMapping{{PathOnHost: "/dev/null"}},
+		},
+	}
+	// Containers created by earlier versions may have these set, so they
+	// are only rejected on create.
+	if _, err := verifyPlatformContainerSettings(nil, hostConfig, nil, false); err != nil {
+		t.Fatalf("expected no error on start, got %v", err)
+	}
+	if _, err := verifyPlatformContainerSettings(nil, hostConfig, &containertypes.Config{}, false); err == nil {
+		t.Fatal("expected an error on create")
+	}
+}
+
+func TestAddErrorAttributes(t *testing.T) {
+	cases := []struct {
+		err  error
+		want string
+	}{
+		{&hcsshim.HcsError{Err: syscall.Errno(170)}, "0x800700aa"},
+		{&hcsshim.HcsError{Err: syscall.Errno(0x800401F3)}, "0x800401f3"},
+		{&hcsshim.HcsError{Err: errors.New("not an errno")}, ""},
+		{errors.New("not an HCS error"), ""},
+	}
+
+	for _, c := range cases {
+		attributes := map[string]string{}
+		addErrorAttributes(attributes, c.err)
+		if got := attributes["hresult"]; got != c.want {
+			t.Errorf("addErrorAttributes(%v) set hresult %q, expected %q", c.err, got, c.want)
+		}
+	}
+}
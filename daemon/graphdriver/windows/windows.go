@@ -3,7 +3,6 @@
 package windows
 
 import (
-	"bufio"
 	"crypto/sha512"
 	"encoding/json"
 	"fmt"
@@ -28,6 +27,7 @@ import (
 	"github.com/docker/docker/pkg/idtools"
 	"github.com/docker/docker/pkg/ioutils"
 	"github.com/docker/docker/pkg/longpath"
+	"github.com/docker/docker/pkg/pools"
 	"github.com/docker/docker/pkg/system"
 	"github.com/vbatts/tar-split/tar/storage"
 )
@@ -562,7 +562,14 @@ func (d *Driver) exportLayer(id string, parentLayerPaths []string) (archive.Arch
 
 	archive, w := io.Pipe()
 	go func() {
-		err := writeTarFromLayer(r, w)
+		// Buffer the many small writes made by the tar writer so that they
+		// are handed over the pipe in larger chunks.
+		buf := pools.BufioWriter32KPool.Get(w)
+		err := writeTarFromLayer(r, buf)
+		if err == nil {
+			err = buf.Flush()
+		}
+		pools.BufioWriter32KPool.Put(buf)
 		cerr := r.Close()
 		if err == nil {
 			err = cerr
@@ -577,7 +584,8 @@ func writeLayerFromTar(r archive.Reader, w hcsshim.LayerWriter) (int64, error) {
 	t := tar.NewReader(r)
 	hdr, err := t.Next()
 	totalSize := int64(0)
-	buf := bufio.NewWriter(nil)
+	buf := pools.BufioWriter32KPool.Get(nil)
+	defer pools.BufioWriter32KPool.Put(buf)
 	for err == nil {
 		base := path.Base(hdr.Name)
 		if strings.HasPrefix(base, archive.WhiteoutPrefix) {
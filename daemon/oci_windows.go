@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"syscall"
 
 	"github.com/docker/docker/container"
@@ -128,10 +129,17 @@ func (daemon *Daemon) createSpec(c *container.Container) (*libcontainerd.Spec, e
 	}
 
 	// In s.Windows.Networking
-	// Connect all the libnetwork allocated networks to the container
+	// Connect all the libnetwork allocated networks to the container. The
+	// networks are walked in name order so that the endpoints are always
+	// presented to the container in the same order, across restarts too.
 	var epList []string
 	if c.NetworkSettings != nil {
+		networks := make([]string, 0, len(c.NetworkSettings.Networks))
 		for n := range c.NetworkSettings.Networks {
+			networks = append(networks, n)
+		}
+		sort.Strings(networks)
+		for _, n := range networks {
 			sn, err := daemon.FindNetwork(n)
 			if err != nil {
 				continue
@@ -169,7 +177,7 @@ func (daemon *Daemon) createSpec(c *container.Container) (*libcontainerd.Spec, e
 		//TODO Reservation: ...,
 		},
 		Network: &windowsoci.Network{
-		//TODO Bandwidth: ...,
+			Bandwidth: &c.HostConfig.NetworkMaximumBandwidth,
 		},
 		Storage: &windowsoci.Storage{
 		//TODO Bps: ...,
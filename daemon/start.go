@@ -110,6 +110,7 @@ func (daemon *Daemon) containerStart(container *container.Container) (err error)
 			attributes := map[string]string{
 				"exitCode": fmt.Sprintf("%d", container.ExitCode),
 			}
+			addErrorAttributes(attributes, err)
 			daemon.LogContainerEventWithAttributes(container, "die", attributes)
 		}
 	}()
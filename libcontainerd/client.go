@@ -1,7 +1,6 @@
 package libcontainerd
 
 import (
-	"fmt"
 	"sync"
 
 	"github.com/docker/docker/pkg/locker"
@@ -40,7 +39,7 @@ func (clnt *client) getContainer(containerID string) (*container, error) {
 	container, ok := clnt.containers[containerID]
 	defer clnt.mapMutex.RUnlock()
 	if !ok {
-		return nil, fmt.Errorf("invalid container: %s", containerID) // fixme: typed error
+		return nil, errContainerNotFound{containerID}
 	}
 	return container, nil
 }
@@ -3,8 +3,6 @@
 package libcontainerd
 
 import (
-	"fmt"
-
 	"github.com/Sirupsen/logrus"
 	containerd "github.com/docker/containerd/api/grpc/types"
 )
@@ -17,7 +15,7 @@ func (clnt *client) restore(cont *containerd.Container, options ...CreateOption)
 
 	containerID := cont.Id
 	if _, err := clnt.getContainer(containerID); err == nil {
-		return fmt.Errorf("container %s is already active", containerID)
+		return errContainerExists{containerID}
 	}
 
 	defer func() {
@@ -23,10 +23,12 @@ type client struct {
 // These really should be ALL_CAPS to match golangs syscall library and standard
 // Win32 error conventions, but golint insists on CamelCase.
 const (
-	CoEClassstring     = syscall.Errno(0x800401F3) // Invalid class string
-	ErrorNoNetwork     = syscall.Errno(1222)       // The network is not present or not started
-	ErrorBadPathname   = syscall.Errno(161)        // The specified path is invalid
-	ErrorInvalidObject = syscall.Errno(0x800710D8) // The object identifier does not represent a valid object
+	CoEClassstring      = syscall.Errno(0x800401F3) // Invalid class string
+	ErrorNoNetwork      = syscall.Errno(1222)       // The network is not present or not started
+	ErrorBadPathname    = syscall.Errno(161)        // The specified path is invalid
+	ErrorInvalidObject  = syscall.Errno(0x800710D8) // The object identifier does not represent a valid object
+	ErrorBusy           = syscall.Errno(170)        // The requested resource is in use
+	ErrorObjectNotFound = syscall.Errno(0x10D8)     // ErrorInvalidObject, as hcsshim returns it after converting the HRESULT
 )
 
 type layer struct {
@@ -108,6 +110,24 @@ const defaultOwner = "docker"
 func (clnt *client) Create(containerID string, spec Spec, options ...CreateOption) error {
 	logrus.Debugln("LCD client.Create() with spec", spec)
 
+	clnt.lock(containerID)
+	defer clnt.unlock(containerID)
+
+	if ctr, err := clnt.getContainer(containerID); err == nil {
+		if !ctr.restarting {
+			return errContainerExists{containerID}
+		}
+		// The container is waiting to be restarted by its restart policy,
+		// which this create supersedes.
+		ctr.restartManager.Cancel()
+		clnt.deleteContainer(containerID)
+	}
+
+	endpoints, err := validateSpec(spec)
+	if err != nil {
+		return err
+	}
+
 	cu := &containerInit{
 		SystemType: "Container",
 		Name:       containerID,
@@ -186,6 +206,12 @@ func (clnt *client) Create(containerID string, spec Spec, options ...CreateOptio
 	}
 	cu.MappedDirectories = mds
 
+	if spec.Windows.Resources != nil {
+		if err := applyNetworkResources(endpoints, spec.Windows.Resources.Network); err != nil {
+			return err
+		}
+	}
+
 	configurationb, err := json.Marshal(cu)
 	if err != nil {
 		return err
@@ -193,7 +219,17 @@ func (clnt *client) Create(containerID string, spec Spec, options ...CreateOptio
 
 	// Create the compute system
 	configuration := string(configurationb)
-	if err := hcsshim.CreateComputeSystem(containerID, configuration); err != nil {
+	if err := retryTransient("CreateComputeSystem "+containerID, func() error {
+		err := hcsshim.CreateComputeSystem(containerID, configuration)
+		if isTransientError(err) {
+			// The failed create may have left a compute system behind,
+			// which would make the next attempt fail as already existing.
+			if _, err := terminateComputeSystem(containerID, "CreateComputeSystem failed"); err != nil {
+				logrus.Warnf("Failed to terminate compute system %s after a failed create: %s", containerID, err)
+			}
+		}
+		return err
+	}); err != nil {
 		return err
 	}
 
@@ -236,14 +272,23 @@ func (clnt *client) Create(containerID string, spec Spec, options ...CreateOptio
 
 // AddProcess is the handler for adding a process to an already running
 // container. It's called through docker exec.
+//
+// AddProcess may be called concurrently for the same container. The
+// container lock is held while the process is created in HCS and added to
+// the container's list of processes, so concurrent calls cannot interleave
+// those steps. It is released before calling back into the daemon to attach
+// the streams, as the daemon may itself call into the client.
 func (clnt *client) AddProcess(containerID, processFriendlyName string, procToAdd Process) error {
-
 	clnt.lock(containerID)
-	defer clnt.unlock(containerID)
 	container, err := clnt.getContainer(containerID)
 	if err != nil {
+		clnt.unlock(containerID)
 		return err
 	}
+	if _, ok := container.processes[processFriendlyName]; ok {
+		clnt.unlock(containerID)
+		return fmt.Errorf("process %s already exists in container %s", processFriendlyName, containerID)
+	}
 
 	createProcessParms := hcsshim.CreateProcessParams{
 		EmulateConsole: procToAdd.Terminal,
@@ -279,6 +324,7 @@ func (clnt *client) AddProcess(containerID, processFriendlyName string, procToAd
 		!procToAdd.Terminal,
 		createProcessParms)
 	if err != nil {
+		clnt.unlock(containerID)
 		logrus.Errorf("AddProcess %s CreateProcessInComputeSystem() failed %s", containerID, err)
 		return err
 	}
@@ -311,9 +357,6 @@ func (clnt *client) AddProcess(containerID, processFriendlyName string, procToAd
 		return err
 	}
 
-	// Lock again so that the defer unlock doesn't fail. (I really don't like this code)
-	clnt.lock(containerID)
-
 	// Spin up a go routine waiting for exit to handle cleanup
 	go container.waitExit(pid, processFriendlyName, false)
 
@@ -407,13 +450,49 @@ func (clnt *client) Stats(containerID string) (*Stats, error) {
 func (clnt *client) Restore(containerID string, unusedOnWindows ...CreateOption) error {
 	// TODO Windows: Implement this. For now, just tell the backend the container exited.
 	logrus.Debugf("lcd Restore %s", containerID)
+
+	// The container was running when the daemon stopped, so if the daemon
+	// did not shut down cleanly its compute system may still exist. As it
+	// cannot be re-adopted, terminate it so it doesn't hold on to the
+	// container's layers and networking.
+	if terminated, err := terminateComputeSystem(containerID, "Restore"); err != nil {
+		logrus.Warnf("Failed to terminate orphaned compute system %s: %s", containerID, err)
+	} else if terminated {
+		logrus.Infof("Terminated orphaned compute system %s", containerID)
+	}
+
 	return clnt.backend.StateChanged(containerID, StateInfo{
 		CommonStateInfo: CommonStateInfo{
 			State:    StateExit,
-			ExitCode: 1 << 31,
+			ExitCode: exitCodeUnknown,
 		}})
 }
 
+// terminateComputeSystem terminates the compute system of a container if one
+// exists, returning whether it did. Like the other callers, it waits for the
+// termination without a timeout.
+func terminateComputeSystem(containerID, context string) (bool, error) {
+	if err := hcsshim.TerminateComputeSystem(containerID, hcsshim.TimeoutInfinite, context); err != nil {
+		if isComputeSystemNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isComputeSystemNotFound returns whether err is the error HCS returns for a
+// compute system which does not exist.
+func isComputeSystemNotFound(err error) bool {
+	herr, ok := err.(*hcsshim.HcsError)
+	if !ok {
+		return false
+	}
+	return herr.Err == ErrorBadPathname ||
+		herr.Err == ErrorObjectNotFound ||
+		herr.Err == syscall.ERROR_PATH_NOT_FOUND
+}
+
 // GetPidsForContainer returns a list of process IDs running in a container.
 // Although implemented, this is not used in Windows.
 func (clnt *client) GetPidsForContainer(containerID string) ([]int, error) {
@@ -0,0 +1,37 @@
+package libcontainerd
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+
+	"github.com/Microsoft/hcsshim"
+)
+
+func TestIsComputeSystemNotFound(t *testing.T) {
+	// hcsshim converts Win32 facility HRESULTs to Win32 error codes before
+	// wrapping them, as win32FromHresult does.
+	win32FromHresult := func(hr uint32) syscall.Errno {
+		if hr&0x1fff0000 == 0x00070000 {
+			return syscall.Errno(hr & 0xffff)
+		}
+		return syscall.Errno(hr)
+	}
+
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{&hcsshim.HcsError{Err: win32FromHresult(uint32(ErrorInvalidObject))}, true},
+		{&hcsshim.HcsError{Err: win32FromHresult(0x80070003)}, true},
+		{&hcsshim.HcsError{Err: ErrorBadPathname}, true},
+		{&hcsshim.HcsError{Err: win32FromHresult(0x80070005)}, false},
+		{errors.New("not an HCS error"), false},
+	}
+
+	for _, c := range cases {
+		if got := isComputeSystemNotFound(c.err); got != c.want {
+			t.Errorf("isComputeSystemNotFound(%v) = %v, expected %v", c.err, got, c.want)
+		}
+	}
+}
@@ -9,6 +9,11 @@ import (
 	"github.com/Sirupsen/logrus"
 )
 
+// exitCodeUnknown is reported as the exit code of a process whose real exit
+// code cannot be determined, for example because waiting for it failed or
+// its container could not be restored.
+const exitCodeUnknown uint32 = 1 << 31
+
 type container struct {
 	containerCommon
 
@@ -91,11 +96,13 @@ func (ctr *container) start() error {
 	logrus.Debugf("Process started - PID %d", pid)
 	ctr.systemPid = uint32(pid)
 
+	// Track the container before waiting for it to exit, otherwise a process
+	// which exits immediately could be removed before it is added.
+	ctr.client.appendContainer(ctr)
+
 	// Spin up a go routine waiting for exit to handle cleanup
 	go ctr.waitExit(pid, InitFriendlyName, true)
 
-	ctr.client.appendContainer(ctr)
-
 	if err := ctr.client.backend.AttachStreams(ctr.containerID, *iopipe); err != nil {
 		// OK to return the error here, as waitExit will handle tear-down in HCS
 		return err
@@ -118,10 +125,14 @@ func (ctr *container) waitExit(pid uint32, processFriendlyName string, isFirstPr
 	logrus.Debugln("waitExit on pid", pid)
 
 	// Block indefinitely for the process to exit.
-	exitCode, err := hcsshim.WaitForProcessInComputeSystem(ctr.containerID, pid, hcsshim.TimeoutInfinite)
+	code, err := hcsshim.WaitForProcessInComputeSystem(ctr.containerID, pid, hcsshim.TimeoutInfinite)
+	exitCode := uint32(code)
 	if err != nil {
 		if herr, ok := err.(*hcsshim.HcsError); ok && herr.Err != syscall.ERROR_BROKEN_PIPE {
 			logrus.Warnf("WaitForProcessInComputeSystem failed (container may have been killed): %s", err)
+			// The exit code returned on failure is 0, which would look like a
+			// clean exit and stop on-failure restart policies from applying.
+			exitCode = exitCodeUnknown
 		}
 		// Fall through here, do not return. This ensures we attempt to continue the
 		// shutdown in HCS nad tell the docker engine that the process/container
@@ -132,16 +143,20 @@ func (ctr *container) waitExit(pid uint32, processFriendlyName string, isFirstPr
 	si := StateInfo{
 		CommonStateInfo: CommonStateInfo{
 			State:     StateExit,
-			ExitCode:  uint32(exitCode),
+			ExitCode:  exitCode,
 			Pid:       pid,
 			ProcessID: processFriendlyName,
 		},
 		UpdatePending: false,
 	}
 
-	// But it could have been an exec'd process which exited
+	// But it could have been an exec'd process which exited, in which case
+	// stop tracking it so Signal and Summary no longer act on it.
 	if !isFirstProcessToStart {
 		si.State = StateExitProcess
+		ctr.client.lock(ctr.containerID)
+		delete(ctr.processes, processFriendlyName)
+		ctr.client.unlock(ctr.containerID)
 	}
 
 	// If this is the init process, always call into vmcompute.dll to
@@ -168,16 +183,26 @@ func (ctr *container) waitExit(pid uint32, processFriendlyName string, isFirstPr
 		}
 
 		if si.State == StateExit && ctr.restartManager != nil {
-			restart, wait, err := ctr.restartManager.ShouldRestart(uint32(exitCode), false)
+			restart, wait, err := ctr.restartManager.ShouldRestart(exitCode, false)
 			if err != nil {
 				logrus.Error(err)
 			} else if restart {
 				si.State = StateRestart
+				// restarting is read by Create under the container lock.
+				ctr.client.lock(ctr.containerID)
 				ctr.restarting = true
+				ctr.client.unlock(ctr.containerID)
 				go func() {
 					err := <-wait
+					// Create cancels the restart and replaces the container if
+					// it is called while the restart is pending, in which case
+					// the replacement must be left alone.
+					ctr.client.lock(ctr.containerID)
 					ctr.restarting = false
-					ctr.client.deleteContainer(ctr.friendlyName)
+					if cur, err := ctr.client.getContainer(ctr.containerID); err == nil && cur == ctr {
+						ctr.client.deleteContainer(ctr.containerID)
+					}
+					ctr.client.unlock(ctr.containerID)
 					if err != nil {
 						si.State = StateExit
 						if err := ctr.client.backend.StateChanged(ctr.containerID, si); err != nil {
@@ -194,7 +219,7 @@ func (ctr *container) waitExit(pid uint32, processFriendlyName string, isFirstPr
 		// Remove process from list if we have exited
 		// We need to do so here in case the Message Handler decides to restart it.
 		if si.State == StateExit {
-			ctr.client.deleteContainer(ctr.friendlyName)
+			ctr.client.deleteContainer(ctr.containerID)
 		}
 	}
 
@@ -0,0 +1,37 @@
+package libcontainerd
+
+import "fmt"
+
+// errContainerNotFound is returned when an operation refers to a container
+// which is not known to the client.
+type errContainerNotFound struct {
+	id string
+}
+
+func (e errContainerNotFound) Error() string {
+	return fmt.Sprintf("invalid container: %s", e.id)
+}
+
+// errContainerExists is returned when creating or restoring a container which
+// is already active in the client.
+type errContainerExists struct {
+	id string
+}
+
+func (e errContainerExists) Error() string {
+	return fmt.Sprintf("container %s is already active", e.id)
+}
+
+// IsNotFound returns true if the error reports that the container is not
+// known to the client.
+func IsNotFound(err error) bool {
+	_, ok := err.(errContainerNotFound)
+	return ok
+}
+
+// IsAlreadyExists returns true if the error reports that the container is
+// already active in the client.
+func IsAlreadyExists(err error) bool {
+	_, ok := err.(errContainerExists)
+	return ok
+}
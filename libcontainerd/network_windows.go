@@ -0,0 +1,92 @@
+package libcontainerd
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/Microsoft/hcsshim"
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/libcontainerd/windowsoci"
+)
+
+// qosPolicy is the HNS endpoint policy limiting the egress bandwidth of an
+// endpoint. Note the version of HNS targeted here does not support DSCP
+// marking, so only the bandwidth cap is exposed.
+func qosPolicy(maxBandwidth uint64) hcsshim.QosPolicy {
+	return hcsshim.QosPolicy{
+		Type:                            "QOS",
+		MaximumOutgoingBandwidthInBytes: maxBandwidth,
+	}
+}
+
+// addEndpointPolicies appends the given typed policies to an existing HNS
+// endpoint. Each policy is marshalled to the raw JSON form HNS expects, so
+// callers never need to build policy JSON by hand.
+func addEndpointPolicies(endpoint *hcsshim.HNSEndpoint, policies ...interface{}) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	added := false
+	for _, p := range policies {
+		policyb, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		// Policies are re-applied when a container is restarted, so skip
+		// any which the endpoint already has.
+		if hasPolicy(endpoint.Policies, policyb) {
+			continue
+		}
+		endpoint.Policies = append(endpoint.Policies, policyb)
+		added = true
+	}
+	if !added {
+		return nil
+	}
+
+	configurationb, err := json.Marshal(endpoint)
+	if err != nil {
+		return err
+	}
+
+	logrus.Debugf("Updating HNS endpoint %s with %s", endpoint.Id, configurationb)
+	if _, err := hcsshim.HNSEndpointRequest("POST", endpoint.Id, string(configurationb)); err != nil {
+		logrus.Errorf("Failed to update HNS endpoint %s: %s", endpoint.Id, err)
+		return err
+	}
+	return nil
+}
+
+// hasPolicy returns whether policy is semantically equal to one of the
+// existing raw policies of an endpoint.
+func hasPolicy(existing []json.RawMessage, policy []byte) bool {
+	var want map[string]interface{}
+	if err := json.Unmarshal(policy, &want); err != nil {
+		return false
+	}
+	for _, e := range existing {
+		var have map[string]interface{}
+		if err := json.Unmarshal(e, &have); err != nil {
+			continue
+		}
+		if reflect.DeepEqual(have, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyNetworkResources applies the network resource constraints from the
+// spec to each of the endpoints which will be attached to the container.
+func applyNetworkResources(endpoints []*hcsshim.HNSEndpoint, network *windowsoci.Network) error {
+	if network == nil || network.Bandwidth == nil || *network.Bandwidth == 0 {
+		return nil
+	}
+	for _, ep := range endpoints {
+		if err := addEndpointPolicies(ep, qosPolicy(*network.Bandwidth)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
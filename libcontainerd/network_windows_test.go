@@ -0,0 +1,32 @@
+package libcontainerd
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHasPolicy(t *testing.T) {
+	existing := []json.RawMessage{
+		json.RawMessage(`{"Type":"OutBoundNAT","Exceptions":["10.0.0.0/8"]}`),
+		json.RawMessage(`{"Type":"QOS","MaximumOutgoingBandwidthInBytes":1000}`),
+		json.RawMessage(`not json`),
+	}
+
+	cases := []struct {
+		policy string
+		want   bool
+	}{
+		{`{"Type":"QOS","MaximumOutgoingBandwidthInBytes":1000}`, true},
+		{`{"MaximumOutgoingBandwidthInBytes":1000,"Type":"QOS"}`, true},
+		{`{"Type":"QOS","MaximumOutgoingBandwidthInBytes":2000}`, false},
+		{`{"Type":"OutBoundNAT","Exceptions":["10.0.0.0/8"]}`, true},
+		{`{"Type":"OutBoundNAT"}`, false},
+		{`not json`, false},
+	}
+
+	for _, c := range cases {
+		if got := hasPolicy(existing, []byte(c.policy)); got != c.want {
+			t.Errorf("hasPolicy(%s) = %v, expected %v", c.policy, got, c.want)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package libcontainerd
 
 import (
 	"io"
+
+	"github.com/docker/docker/pkg/pools"
 )
 
 // process keeps the state for both main container process and exec process.
@@ -17,7 +19,7 @@ type process struct {
 func openReaderFromPipe(p io.ReadCloser) io.Reader {
 	r, w := io.Pipe()
 	go func() {
-		if _, err := io.Copy(w, p); err != nil {
+		if _, err := pools.Copy(w, p); err != nil {
 			r.CloseWithError(err)
 		}
 		w.Close()
@@ -0,0 +1,52 @@
+package libcontainerd
+
+import (
+	"math/rand"
+	"syscall"
+	"time"
+
+	"github.com/Microsoft/hcsshim"
+	"github.com/Sirupsen/logrus"
+)
+
+// Retry policy for HCS calls which fail with a transient error.
+var (
+	transientRetries = 5
+	transientBackoff = 100 * time.Millisecond
+	transientMaxWait = 2 * time.Second
+)
+
+// isTransientError returns whether err is an HCS error which is expected to
+// clear by itself, such as the resource being temporarily busy.
+func isTransientError(err error) bool {
+	herr, ok := err.(*hcsshim.HcsError)
+	if !ok {
+		return false
+	}
+	return herr.Err == ErrorBusy || herr.Err == syscall.ERROR_IO_PENDING
+}
+
+// retryTransient calls f until it succeeds, fails with an error which is not
+// transient, or the retries are exhausted. The delay between attempts doubles
+// each time, with jitter so that concurrent callers do not retry in lockstep.
+// The error from the last attempt is returned unchanged, earlier failures
+// are only logged.
+func retryTransient(operation string, f func() error) error {
+	backoff := transientBackoff
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || !isTransientError(err) {
+			return err
+		}
+		if attempt > transientRetries {
+			logrus.Warnf("libcontainerd: %s failed with a transient error after %d attempts: %s", operation, attempt, err)
+			return err
+		}
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff)))
+		logrus.Warnf("libcontainerd: %s attempt %d failed with a transient error, retrying in %s: %s", operation, attempt, wait, err)
+		time.Sleep(wait)
+		if backoff *= 2; backoff > transientMaxWait {
+			backoff = transientMaxWait
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package libcontainerd
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/Microsoft/hcsshim"
+)
+
+func TestRetryTransient(t *testing.T) {
+	defer func(retries int, backoff time.Duration) {
+		transientRetries, transientBackoff = retries, backoff
+	}(transientRetries, transientBackoff)
+	transientRetries = 3
+	transientBackoff = time.Millisecond
+
+	busy := &hcsshim.HcsError{Err: ErrorBusy}
+	pending := &hcsshim.HcsError{Err: syscall.ERROR_IO_PENDING}
+	fatal := &hcsshim.HcsError{Err: syscall.ERROR_ACCESS_DENIED}
+	other := errors.New("not an HCS error")
+
+	cases := []struct {
+		name     string
+		errs     []error
+		want     error
+		attempts int
+	}{
+		{"success", []error{nil}, nil, 1},
+		{"not transient", []error{fatal}, fatal, 1},
+		{"not HCS", []error{other}, other, 1},
+		{"transient then success", []error{busy, pending, nil}, nil, 3},
+		{"transient then fatal", []error{busy, fatal}, fatal, 2},
+		{"exhausted", []error{busy, busy, busy, pending}, pending, 4},
+	}
+
+	for _, c := range cases {
+		attempts := 0
+		err := retryTransient(c.name, func() error {
+			err := c.errs[attempts]
+			attempts++
+			return err
+		})
+		if err != c.want {
+			t.Errorf("%s: got error %v, expected %v", c.name, err, c.want)
+		}
+		if attempts != c.attempts {
+			t.Errorf("%s: got %d attempts, expected %d", c.name, attempts, c.attempts)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package libcontainerd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Microsoft/hcsshim"
+	"github.com/Sirupsen/logrus"
+)
+
+// validateSpec checks that everything a spec refers to on the host exists
+// and is well-formed, so that problems are reported with a clear error
+// before a compute system is created rather than as an opaque HCS failure.
+// It returns the HNS endpoints the container will be attached to.
+func validateSpec(spec Spec) ([]*hcsshim.HNSEndpoint, error) {
+	if spec.Windows.LayerFolder == "" {
+		return nil, fmt.Errorf("no layer folder specified")
+	}
+	if err := checkDirectory("layer folder", spec.Windows.LayerFolder); err != nil {
+		return nil, err
+	}
+	for _, layerPath := range spec.Windows.LayerPaths {
+		if err := checkDirectory("layer", layerPath); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, mount := range spec.Mounts {
+		if mount.Destination == "" {
+			return nil, fmt.Errorf("no container path specified for mount of %s", mount.Source)
+		}
+		if !filepath.IsAbs(mount.Source) {
+			return nil, fmt.Errorf("mount source %s must be an absolute path", mount.Source)
+		}
+		if _, err := os.Stat(mount.Source); err != nil {
+			return nil, fmt.Errorf("mount source %s: %s", mount.Source, err)
+		}
+	}
+
+	var endpoints []*hcsshim.HNSEndpoint
+	if spec.Windows.Networking != nil {
+		for _, ep := range spec.Windows.Networking.EndpointList {
+			endpoint, err := hcsshim.HNSEndpointRequest("GET", ep, "")
+			if err != nil {
+				// Return the HCS error as is, so callers can still inspect it.
+				logrus.Errorf("Failed to get HNS endpoint %s: %s", ep, err)
+				return nil, err
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints, nil
+}
+
+// checkDirectory returns an error if path does not exist or is not a
+// directory. what describes the path in the error message.
+func checkDirectory(what, path string) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("%s %s: %s", what, path, err)
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("%s %s is not a directory", what, path)
+	}
+	return nil
+}
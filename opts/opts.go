@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -227,11 +228,34 @@ func validateDomain(val string) (string, error) {
 	}
 	ns := domainRegexp.FindSubmatch([]byte(val))
 	if len(ns) > 0 && len(ns[1]) < 255 {
+		// RFC 1035 limits each label to 63 octets
+		for _, label := range strings.Split(strings.TrimSuffix(string(ns[1]), "."), ".") {
+			if len(label) > 63 {
+				return "", fmt.Errorf("%s is not a valid domain", val)
+			}
+		}
 		return string(ns[1]), nil
 	}
 	return "", fmt.Errorf("%s is not a valid domain", val)
 }
 
+// ValidateDNSOption validates a resolver option for resolv.conf, such as
+// ndots:2 or rotate. Only the syntax is checked, either a single word or a
+// word followed by a colon and a non-negative number. Out of range values are
+// left for the resolver to clamp.
+func ValidateDNSOption(val string) (string, error) {
+	val = strings.TrimSpace(val)
+	if val == "" || strings.ContainsAny(val, " \t\n") {
+		return "", fmt.Errorf("%q is not a valid DNS option", val)
+	}
+	if i := strings.Index(val, ":"); i >= 0 {
+		if n, err := strconv.Atoi(val[i+1:]); i == 0 || err != nil || n < 0 {
+			return "", fmt.Errorf("%s is not a valid DNS option, expected name or name:number", val)
+		}
+	}
+	return val, nil
+}
+
 // ValidateLabel validates that the specified string is a valid label, and returns it.
 // Labels are in the form on key=value.
 func ValidateLabel(val string) (string, error) {
@@ -157,6 +157,7 @@ func TestValidateDNSSearch(t *testing.T) {
 		`foo.bar-.baz`,
 		`foo.-bar`,
 		`foo.-bar.baz`,
+		`foo.thislabelislongerthansixtythreecharacterswhichisnotallowedbyrfc1035.bar`,
 		`foo.bar.baz.this.should.fail.on.long.name.beause.it.is.longer.thanisshouldbethis.should.fail.on.long.name.beause.it.is.longer.thanisshouldbethis.should.fail.on.long.name.beause.it.is.longer.thanisshouldbethis.should.fail.on.long.name.beause.it.is.longer.thanisshouldbe`,
 	}
 
@@ -173,6 +174,43 @@ func TestValidateDNSSearch(t *testing.T) {
 	}
 }
 
+func TestValidateDNSOption(t *testing.T) {
+	valid := []string{
+		`ndots:0`,
+		`ndots:15`,
+		`timeout:1`,
+		`timeout:30`,
+		`attempts:5`,
+		`ndots:20`,
+		`timeout:60`,
+		`rotate`,
+		`edns0`,
+		` single-request `,
+	}
+
+	invalid := []string{
+		``,
+		` `,
+		`ndots:`,
+		`ndots:-1`,
+		`:2`,
+		`timeout:abc`,
+		`rotate edns0`,
+	}
+
+	for _, option := range valid {
+		if ret, err := ValidateDNSOption(option); err != nil || ret == "" {
+			t.Fatalf("ValidateDNSOption(`"+option+"`) got %s %s", ret, err)
+		}
+	}
+
+	for _, option := range invalid {
+		if ret, err := ValidateDNSOption(option); err == nil || ret != "" {
+			t.Fatalf("ValidateDNSOption(`"+option+"`) got %s %s", ret, err)
+		}
+	}
+}
+
 func TestValidateLabel(t *testing.T) {
 	if _, err := ValidateLabel("label"); err == nil || err.Error() != "bad attribute format: label" {
 		t.Fatalf("Expected an error [bad attribute format: label], go %v", err)
@@ -48,7 +48,7 @@ func Parse(cmd *flag.FlagSet, args []string) (*container.Config, *container.Host
 		flExpose            = opts.NewListOpts(nil)
 		flDNS               = opts.NewListOpts(opts.ValidateIPAddress)
 		flDNSSearch         = opts.NewListOpts(opts.ValidateDNSSearch)
-		flDNSOptions        = opts.NewListOpts(nil)
+		flDNSOptions        = opts.NewListOpts(opts.ValidateDNSOption)
 		flExtraHosts        = opts.NewListOpts(ValidateExtraHost)
 		flVolumesFrom       = opts.NewListOpts(nil)
 		flEnvFile           = opts.NewListOpts(nil)
@@ -90,29 +90,33 @@ func TestParseMountSpec(t *testing.T) {
 			"hostPath:/containerPath:ro",
 			"/hostPath:/containerPath:rw",
 			"/rw:/ro",
+			"/hostPath:/containerPath:cached",
+			"/hostPath:/containerPath:ro,delegated",
+			"name:/containerPath:nocopy,consistent",
 		}
 		invalid = map[string]string{
-			"":                "Invalid volume specification",
-			"./":              "Invalid volume destination",
-			"../":             "Invalid volume destination",
-			"/:../":           "Invalid volume destination",
-			"/:path":          "Invalid volume destination",
-			":":               "Invalid volume specification",
-			"/tmp:":           "Invalid volume destination",
-			":test":           "Invalid volume specification",
-			":/test":          "Invalid volume specification",
-			"tmp:":            "Invalid volume destination",
-			":test:":          "Invalid volume specification",
-			"::":              "Invalid volume specification",
-			":::":             "Invalid volume specification",
-			"/tmp:::":         "Invalid volume specification",
-			":/tmp::":         "Invalid volume specification",
-			"/path:rw":        "Invalid volume specification",
-			"/path:ro":        "Invalid volume specification",
-			"/rw:rw":          "Invalid volume specification",
-			"path:ro":         "Invalid volume specification",
-			"/path:/path:sw":  `invalid mode: sw`,
-			"/path:/path:rwz": `invalid mode: rwz`,
+			"":                             "Invalid volume specification",
+			"./":                           "Invalid volume destination",
+			"../":                          "Invalid volume destination",
+			"/:../":                        "Invalid volume destination",
+			"/:path":                       "Invalid volume destination",
+			":":                            "Invalid volume specification",
+			"/tmp:":                        "Invalid volume destination",
+			":test":                        "Invalid volume specification",
+			":/test":                       "Invalid volume specification",
+			"tmp:":                         "Invalid volume destination",
+			":test:":                       "Invalid volume specification",
+			"::":                           "Invalid volume specification",
+			":::":                          "Invalid volume specification",
+			"/tmp:::":                      "Invalid volume specification",
+			":/tmp::":                      "Invalid volume specification",
+			"/path:rw":                     "Invalid volume specification",
+			"/path:ro":                     "Invalid volume specification",
+			"/rw:rw":                       "Invalid volume specification",
+			"path:ro":                      "Invalid volume specification",
+			"/path:/path:sw":               `invalid mode: sw`,
+			"/path:/path:rwz":              `invalid mode: rwz`,
+			"/path:/path:cached,delegated": `invalid mode: cached,delegated`,
 		}
 	}
 
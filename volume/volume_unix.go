@@ -20,6 +20,15 @@ var labelModes = map[string]bool{
 	"z": true,
 }
 
+// consistency modes. These are accepted for compatibility with platforms
+// where bind mounts are shared across a VM boundary, and have no effect here
+// as a bind mount on the host is always fully consistent.
+var consistencyModes = map[string]bool{
+	"consistent": true,
+	"cached":     true,
+	"delegated":  true,
+}
+
 // BackwardsCompatible decides whether this mount point can be
 // used in old versions of Docker or not.
 // Only bind mounts and local volumes can be used in old versions of Docker.
@@ -145,6 +154,7 @@ func ValidMountMode(mode string) bool {
 	labelModeCount := 0
 	propagationModeCount := 0
 	copyModeCount := 0
+	consistencyModeCount := 0
 
 	for _, o := range strings.Split(mode, ",") {
 		switch {
@@ -156,13 +166,15 @@ func ValidMountMode(mode string) bool {
 			propagationModeCount++
 		case copyModeExists(o):
 			copyModeCount++
+		case consistencyModes[o]:
+			consistencyModeCount++
 		default:
 			return false
 		}
 	}
 
 	// Only one string for each mode is allowed.
-	if rwModeCount > 1 || labelModeCount > 1 || propagationModeCount > 1 || copyModeCount > 1 {
+	if rwModeCount > 1 || labelModeCount > 1 || propagationModeCount > 1 || copyModeCount > 1 || consistencyModeCount > 1 {
 		return false
 	}
 	return true